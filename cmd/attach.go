@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/covexo/devspace/pkg/devspace/clients/kubectl"
+	"github.com/covexo/devspace/pkg/devspace/config/v1"
+	"github.com/covexo/devspace/pkg/util/log"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AttachCmd holds the information needed for the attach command
+type AttachCmd struct {
+	flags         *AttachCmdFlags
+	kubectl       *kubernetes.Clientset
+	privateConfig *v1.PrivateConfig
+	dsConfig      *v1.DevSpaceConfig
+	workdir       string
+}
+
+// AttachCmdFlags holds the possible flags for the attach command
+type AttachCmdFlags struct {
+	Container string
+}
+
+func init() {
+	cmd := &AttachCmd{
+		flags: &AttachCmdFlags{},
+	}
+
+	attachCmd := &cobra.Command{
+		Use:   "attach",
+		Short: "Attaches to the running devspace pod",
+		Long: `
+	#######################################################
+	################### devspace attach ####################
+	#######################################################
+	Attaches to the main process of the running devspace pod
+	#######################################################
+	`,
+		Run: cmd.RunAttach,
+	}
+
+	attachCmd.Flags().StringVarP(&cmd.flags.Container, "container", "c", "", "Container name, if the pod has more than one")
+
+	rootCmd.AddCommand(attachCmd)
+}
+
+// RunAttach executes the devspace attach command logic
+func (cmd *AttachCmd) RunAttach(cobraCmd *cobra.Command, args []string) {
+	loadConfig(&cmd.workdir, &cmd.privateConfig, &cmd.dsConfig)
+
+	var err error
+	cmd.kubectl, err = kubectl.NewClient()
+
+	if err != nil {
+		log.Fatalf("Unable to create new kubectl client: %s", err.Error())
+	}
+
+	pod, err := kubectl.ResolveDevspacePod(cmd.kubectl, cmd.privateConfig)
+
+	if err != nil {
+		log.Fatalf("Unable to find running devspace pod: %s", err.Error())
+	}
+
+	container, err := resolveContainer(pod, cmd.flags.Container)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	err = kubectl.Attach(cmd.kubectl, pod, container, os.Stdin, os.Stdout, os.Stderr)
+
+	if err != nil {
+		log.Fatalf("Error attaching to devspace pod: %s", err.Error())
+	}
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/covexo/devspace/pkg/devspace/clients/kubectl"
+	"github.com/covexo/devspace/pkg/devspace/config/v1"
+	"github.com/covexo/devspace/pkg/util/log"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ExecCmd holds the information needed for the exec command
+type ExecCmd struct {
+	flags         *ExecCmdFlags
+	kubectl       *kubernetes.Clientset
+	privateConfig *v1.PrivateConfig
+	dsConfig      *v1.DevSpaceConfig
+	workdir       string
+}
+
+// ExecCmdFlags holds the possible flags for the exec command
+type ExecCmdFlags struct {
+	Container string
+}
+
+func init() {
+	cmd := &ExecCmd{
+		flags: &ExecCmdFlags{},
+	}
+
+	execCmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Runs a command inside the running devspace pod",
+		Long: `
+	#######################################################
+	#################### devspace exec #####################
+	#######################################################
+	Runs a command inside the running devspace pod, e.g.
+
+	devspace exec -- bash
+	devspace exec -c my-container -- ls /app
+	#######################################################
+	`,
+		Run: cmd.RunExec,
+	}
+
+	execCmd.Flags().StringVarP(&cmd.flags.Container, "container", "c", "", "Container name, if the pod has more than one")
+
+	rootCmd.AddCommand(execCmd)
+}
+
+// RunExec executes the devspace exec command logic
+func (cmd *ExecCmd) RunExec(cobraCmd *cobra.Command, args []string) {
+	loadConfig(&cmd.workdir, &cmd.privateConfig, &cmd.dsConfig)
+
+	if len(args) == 0 {
+		log.Fatal("Please specify a command to run, e.g. devspace exec -- bash")
+	}
+
+	var err error
+	cmd.kubectl, err = kubectl.NewClient()
+
+	if err != nil {
+		log.Fatalf("Unable to create new kubectl client: %s", err.Error())
+	}
+
+	pod, err := kubectl.ResolveDevspacePod(cmd.kubectl, cmd.privateConfig)
+
+	if err != nil {
+		log.Fatalf("Unable to find running devspace pod: %s", err.Error())
+	}
+
+	container, err := resolveContainer(pod, cmd.flags.Container)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	err = kubectl.Exec(cmd.kubectl, pod, container, args, os.Stdin, os.Stdout, os.Stderr)
+
+	if err != nil {
+		log.Fatalf("Error executing command in devspace pod: %s", err.Error())
+	}
+}
+
+// resolveContainer returns the requested container name, or the pod's only container if there is
+// exactly one, or prompts the user to pick one when the pod has several and none was requested
+func resolveContainer(pod *corev1.Pod, requested string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	if len(pod.Spec.Containers) == 1 {
+		return pod.Spec.Containers[0].Name, nil
+	}
+
+	names := make([]string, 0, len(pod.Spec.Containers))
+
+	fmt.Println("This pod has multiple containers. Please select one:")
+
+	for i, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+		fmt.Printf("  [%d] %s\n", i+1, container.Name)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Container: ")
+
+	line, err := reader.ReadString('\n')
+
+	if err != nil {
+		return "", err
+	}
+
+	line = strings.TrimSpace(line)
+
+	if index, err := strconv.Atoi(line); err == nil && index >= 1 && index <= len(names) {
+		return names[index-1], nil
+	}
+
+	for _, name := range names {
+		if name == line {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("Unknown container %s", line)
+}
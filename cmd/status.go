@@ -1,16 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	helmClient "github.com/covexo/devspace/pkg/devspace/clients/helm"
 	"github.com/covexo/devspace/pkg/devspace/clients/kubectl"
 	"github.com/covexo/devspace/pkg/devspace/config/v1"
+	"github.com/covexo/devspace/pkg/devspace/statuscheck"
 	"github.com/covexo/devspace/pkg/util/log"
 	"github.com/daviddengcn/go-colortext"
 	"github.com/spf13/cobra"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -27,6 +30,12 @@ type StatusCmd struct {
 
 // StatusCmdFlags holds the possible flags for the list command
 type StatusCmdFlags struct {
+	Wait    bool
+	Timeout int
+	Watch   bool
+	Output  string
+	Since   string
+	Type    string
 }
 
 func init() {
@@ -47,6 +56,10 @@ func init() {
 		Run: cmd.RunStatus,
 	}
 
+	statusCmd.Flags().BoolVar(&cmd.flags.Wait, "wait", false, "Wait until all devspace resources report ready instead of printing a single snapshot")
+	statusCmd.Flags().IntVar(&cmd.flags.Timeout, "timeout", 120, "Maximum number of seconds to wait when --wait is set")
+	statusCmd.Flags().StringVarP(&cmd.flags.Output, "output", "o", "table", "Output format. One of: table|json|yaml|wide")
+
 	rootCmd.AddCommand(statusCmd)
 
 	statusSyncCmd := &cobra.Command{
@@ -62,20 +75,35 @@ func init() {
 		Run: cmd.RunStatusSync,
 	}
 
+	statusSyncCmd.Flags().BoolVar(&cmd.flags.Watch, "watch", false, "Refresh the sync status in place instead of printing it once")
+	statusSyncCmd.Flags().StringVarP(&cmd.flags.Output, "output", "o", "table", "Output format. One of: table|json")
+
 	statusCmd.AddCommand(statusSyncCmd)
+
+	statusEventsCmd := &cobra.Command{
+		Use:   "events",
+		Short: "Shows the devspace events",
+		Long: `
+	#######################################################
+	############### devspace status events ################
+	#######################################################
+	Shows recent Kubernetes events for the devspace release
+	#######################################################
+	`,
+		Run: cmd.RunStatusEvents,
+	}
+
+	statusEventsCmd.Flags().StringVar(&cmd.flags.Since, "since", "", "Only show events newer than a relative duration like 5m or 2h")
+	statusEventsCmd.Flags().BoolVar(&cmd.flags.Watch, "watch", false, "Stream events as they happen instead of printing a single snapshot")
+	statusEventsCmd.Flags().StringVar(&cmd.flags.Type, "type", "", "Only show events of this type, e.g. Warning")
+
+	statusCmd.AddCommand(statusEventsCmd)
 }
 
 // RunStatus executes the devspace status command logic
 func (cmd *StatusCmd) RunStatus(cobraCmd *cobra.Command, args []string) {
 	var err error
-	var values [][]string
-	var headerValues = []string{
-		"TYPE",
-		"STATUS",
-		"POD",
-		"NAMESPACE",
-		"INFO",
-	}
+	report := &StatusReport{}
 
 	loadConfig(&cmd.workdir, &cmd.privateConfig, &cmd.dsConfig)
 
@@ -89,19 +117,12 @@ func (cmd *StatusCmd) RunStatus(cobraCmd *cobra.Command, args []string) {
 	tillerStatus, err := cmd.getTillerStatus()
 
 	if err != nil {
-		values = append(values, []string{
-			"Tiller",
-			"Error",
-			"",
-			"",
-			err.Error(),
-		})
-
-		log.PrintTable(headerValues, values)
+		report.Tiller = ComponentStatus{Type: "Tiller", Phase: "Error", Message: err.Error()}
+		report.Print(cmd.flags.Output)
 		return
 	}
 
-	values = append(values, tillerStatus)
+	report.Tiller = *tillerStatus
 	cmd.helm, err = helmClient.NewClient(cmd.kubectl, false)
 
 	if err != nil {
@@ -111,35 +132,22 @@ func (cmd *StatusCmd) RunStatus(cobraCmd *cobra.Command, args []string) {
 	registryStatus, err := cmd.getRegistryStatus()
 
 	if err != nil {
-		values = append(values, []string{
-			"Docker Registry",
-			"Not Deployed",
-			"",
-			"",
-			err.Error(),
-		})
+		report.Registry = ComponentStatus{Type: "Docker Registry", Phase: "Not Deployed", Message: err.Error()}
 	} else {
-		values = append(values, registryStatus)
+		report.Registry = *registryStatus
 	}
 
-	devspaceStatus, err := cmd.getDevspaceStatus()
+	devspaceStatus, describe, err := cmd.getDevspaceStatus()
 
 	if err != nil {
-		values = append(values, []string{
-			"Devspace",
-			"Error",
-			"",
-			"",
-			err.Error(),
-		})
-
-		log.PrintTable(headerValues, values)
+		report.Devspace = ComponentStatus{Type: "Devspace", Phase: "Error", Message: err.Error()}
+		report.Print(cmd.flags.Output)
 
 		// Print Describes of failed devspace pods
-		if devspaceStatus != nil {
+		if len(describe) > 0 {
 			log.Info("Below details of the not running devspace pods are shown")
 
-			for k, v := range devspaceStatus {
+			for k, v := range describe {
 				if k > 0 {
 					log.WriteColored("--------------------------------------------------------\n", ct.Green)
 				}
@@ -147,14 +155,83 @@ func (cmd *StatusCmd) RunStatus(cobraCmd *cobra.Command, args []string) {
 				log.Write("\n" + v + "\n\n")
 			}
 		}
+
+		return
+	}
+
+	report.Devspace = *devspaceStatus
+
+	extras, err := cmd.getResourceStatuses()
+
+	if err != nil {
+		log.Warnf("Unable to determine resource readiness: %s", err.Error())
 	} else {
-		values = append(values, devspaceStatus)
+		report.Extras = extras
+	}
+
+	report.Print(cmd.flags.Output)
+}
+
+// getResourceStatuses walks every resource rendered by the devspace helm release and evaluates its
+// readiness using pkg/devspace/statuscheck, optionally blocking until everything is ready
+func (cmd *StatusCmd) getResourceStatuses() ([]ComponentStatus, error) {
+	releases, err := cmd.helm.Client.ListReleases()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, release := range releases.Releases {
+		if release.GetName() != cmd.privateConfig.Release.Name {
+			continue
+		}
+
+		checker := statuscheck.NewChecker(cmd.kubectl, cmd.privateConfig.Release.Namespace)
+		ctx := context.Background()
+		resources := []statuscheck.Resource{}
 
-		log.PrintTable(headerValues, values)
+		if cmd.flags.Wait {
+			resources, err = checker.Wait(ctx, release.Manifest, time.Duration(cmd.flags.Timeout)*time.Second, 2*time.Second)
+
+			if err != nil && err != context.DeadlineExceeded {
+				return nil, err
+			}
+		} else {
+			resources, err = checker.CheckManifest(ctx, release.Manifest)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		statuses := make([]ComponentStatus, 0, len(resources))
+
+		for _, resource := range resources {
+			phase := "Running"
+
+			if !resource.Ready {
+				phase = "Not Ready"
+			}
+
+			statuses = append(statuses, ComponentStatus{
+				Type:      resource.Kind,
+				Phase:     phase,
+				Pod:       resource.Name,
+				Namespace: resource.Namespace,
+				Message:   resource.Message,
+				Conditions: []Condition{
+					{Type: "Ready", Status: fmt.Sprintf("%t", resource.Ready), Message: resource.Message},
+				},
+			})
+		}
+
+		return statuses, nil
 	}
+
+	return nil, fmt.Errorf("Devspace helm release %s not found", cmd.privateConfig.Release.Name)
 }
 
-func (cmd *StatusCmd) getRegistryStatus() ([]string, error) {
+func (cmd *StatusCmd) getRegistryStatus() (*ComponentStatus, error) {
 	releases, err := cmd.helm.Client.ListReleases()
 
 	if err != nil {
@@ -183,13 +260,7 @@ func (cmd *StatusCmd) getRegistryStatus() ([]string, error) {
 
 			for _, pod := range registryPods.Items {
 				if kubectl.GetPodStatus(&pod) == "Running" {
-					return []string{
-						"Docker Registry",
-						"Running",
-						pod.GetName(),
-						pod.GetNamespace(),
-						fmt.Sprintf("Created: %s", pod.GetCreationTimestamp().String()),
-					}, nil
+					return podComponentStatus("Docker Registry", &pod), nil
 				}
 			}
 
@@ -200,7 +271,7 @@ func (cmd *StatusCmd) getRegistryStatus() ([]string, error) {
 	return nil, fmt.Errorf("Registry helm release %s not found", cmd.privateConfig.Registry.Release.Name)
 }
 
-func (cmd *StatusCmd) getTillerStatus() ([]string, error) {
+func (cmd *StatusCmd) getTillerStatus() (*ComponentStatus, error) {
 	tillerPod, err := kubectl.GetPodsFromDeployment(cmd.kubectl, helmClient.TillerDeploymentName, cmd.privateConfig.Cluster.TillerNamespace)
 
 	if err != nil {
@@ -213,59 +284,43 @@ func (cmd *StatusCmd) getTillerStatus() ([]string, error) {
 
 	for _, pod := range tillerPod.Items {
 		if kubectl.GetPodStatus(&pod) == "Running" {
-			return []string{
-				"Tiller",
-				"Running",
-				pod.GetName(),
-				pod.GetNamespace(),
-				fmt.Sprintf("Created: %s", pod.GetCreationTimestamp().String()),
-			}, nil
+			return podComponentStatus("Tiller", &pod), nil
 		}
 	}
 
 	return nil, errors.New("No running tiller pod found")
 }
 
-func (cmd *StatusCmd) getDevspaceStatus() ([]string, error) {
+// getDevspaceStatus returns the status of the running devspace pod, or, if none is running, a
+// "kubectl describe" dump of each candidate pod so the caller can show the operator what is wrong
+func (cmd *StatusCmd) getDevspaceStatus() (*ComponentStatus, []string, error) {
 	releases, err := cmd.helm.Client.ListReleases()
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(releases.Releases) == 0 {
-		return nil, errors.New("No release found")
+		return nil, nil, errors.New("No release found")
 	}
 
 	for _, release := range releases.Releases {
 		if release.GetName() == cmd.privateConfig.Release.Name {
 			if release.Info.Status.Code.String() != "DEPLOYED" {
-				return nil, fmt.Errorf("Devspace helm release %s has bad status: %s", cmd.privateConfig.Release.Name, release.Info.Status.Code.String())
+				return nil, nil, fmt.Errorf("Devspace helm release %s has bad status: %s", cmd.privateConfig.Release.Name, release.Info.Status.Code.String())
 			}
 
-			pods, err := cmd.kubectl.Core().Pods(cmd.privateConfig.Release.Namespace).List(metav1.ListOptions{
-				LabelSelector: "release=" + cmd.privateConfig.Release.Name,
-			})
+			pod, err := kubectl.ResolveDevspacePod(cmd.kubectl, cmd.privateConfig)
 
-			if err != nil {
-				return nil, err
+			if err == nil {
+				return podComponentStatus("Devspace", pod), nil, nil
 			}
 
-			if len(pods.Items) == 0 {
-				return nil, errors.New("No devspace pod found")
-			}
+			// No running pod - fall back to a describe dump of every candidate so the operator can see why
+			pods, listErr := kubectl.ListDevspacePods(cmd.kubectl, cmd.privateConfig)
 
-			for _, pod := range pods.Items {
-				// Print Describe on devspace error
-				if kubectl.GetPodStatus(&pod) == "Running" {
-					return []string{
-						"Devspace",
-						"Running",
-						pod.GetName(),
-						pod.GetNamespace(),
-						fmt.Sprintf("Created: %s", pod.GetCreationTimestamp().String()),
-					}, nil
-				}
+			if listErr != nil {
+				return nil, nil, err
 			}
 
 			describe := make([]string, 0, len(pods.Items))
@@ -278,14 +333,39 @@ func (cmd *StatusCmd) getDevspaceStatus() ([]string, error) {
 				}
 			}
 
-			return describe, errors.New("No running devspace pod found")
+			return nil, describe, err
 		}
 	}
 
-	return nil, fmt.Errorf("Devspace helm release %s not found", cmd.privateConfig.Release.Name)
+	return nil, nil, fmt.Errorf("Devspace helm release %s not found", cmd.privateConfig.Release.Name)
 }
 
-// RunStatusSync executes the devspace status sync commad logic
-func (cmd *StatusCmd) RunStatusSync(cobraCmd *cobra.Command, args []string) {
-	log.Info("Run Status Sync")
+// podComponentStatus builds the structured status of a component backed by a single Pod
+func podComponentStatus(componentType string, pod *corev1.Pod) *ComponentStatus {
+	status := &ComponentStatus{
+		Type:      componentType,
+		Phase:     "Running",
+		Pod:       pod.GetName(),
+		Namespace: pod.GetNamespace(),
+		CreatedAt: pod.GetCreationTimestamp().String(),
+		Message:   fmt.Sprintf("Created: %s", pod.GetCreationTimestamp().String()),
+		Node:      pod.Spec.NodeName,
+	}
+
+	if len(pod.Spec.Containers) > 0 {
+		status.Image = pod.Spec.Containers[0].Image
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		status.Restarts += containerStatus.RestartCount
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		status.Conditions = append(status.Conditions, Condition{
+			Type:   string(condition.Type),
+			Status: string(condition.Status),
+		})
+	}
+
+	return status
 }
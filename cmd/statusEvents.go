@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/covexo/devspace/pkg/devspace/clients/kubectl"
+	"github.com/covexo/devspace/pkg/util/log"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RunStatusEvents executes the devspace status events command logic
+func (cmd *StatusCmd) RunStatusEvents(cobraCmd *cobra.Command, args []string) {
+	var err error
+
+	loadConfig(&cmd.workdir, &cmd.privateConfig, &cmd.dsConfig)
+
+	cmd.kubectl, err = kubectl.NewClient()
+
+	if err != nil {
+		log.Fatalf("Unable to create new kubectl client: %s", err.Error())
+	}
+
+	since := time.Duration(0)
+
+	if cmd.flags.Since != "" {
+		since, err = time.ParseDuration(cmd.flags.Since)
+
+		if err != nil {
+			log.Fatalf("Invalid --since duration %s: %s", cmd.flags.Since, err.Error())
+		}
+	}
+
+	events, resourceVersion, err := kubectl.GetReleaseEvents(cmd.kubectl, cmd.privateConfig.Release.Namespace, cmd.privateConfig.Release.Name, since, cmd.flags.Type)
+
+	if err != nil {
+		log.Fatalf("Unable to list devspace events: %s", err.Error())
+	}
+
+	cmd.printEvents(events)
+
+	if !cmd.flags.Watch {
+		return
+	}
+
+	watcher, related, err := kubectl.WatchReleaseEvents(cmd.kubectl, cmd.privateConfig.Release.Namespace, cmd.privateConfig.Release.Name, resourceVersion)
+
+	if err != nil {
+		log.Fatalf("Unable to watch devspace events: %s", err.Error())
+	}
+
+	defer watcher.Stop()
+
+	for result := range watcher.ResultChan() {
+		event, ok := result.Object.(*corev1.Event)
+
+		if !ok || !kubectl.IsRelated(event, related) {
+			continue
+		}
+
+		if cmd.flags.Type != "" && event.Type != cmd.flags.Type {
+			continue
+		}
+
+		cmd.printEvents([]corev1.Event{*event})
+	}
+}
+
+func (cmd *StatusCmd) printEvents(events []corev1.Event) {
+	headerValues := []string{
+		"LAST SEEN",
+		"TYPE",
+		"REASON",
+		"OBJECT",
+		"MESSAGE",
+	}
+	values := make([][]string, 0, len(events))
+
+	for _, event := range events {
+		values = append(values, []string{
+			event.LastTimestamp.Time.Format(time.RFC3339),
+			event.Type,
+			event.Reason,
+			fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			event.Message,
+		})
+	}
+
+	log.PrintTable(headerValues, values)
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/covexo/devspace/pkg/util/log"
+	"gopkg.in/yaml.v2"
+)
+
+// Condition is a single observed condition of a component, e.g. a Pod's "Ready" condition
+type Condition struct {
+	Type    string `json:"type" yaml:"type"`
+	Status  string `json:"status" yaml:"status"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// ComponentStatus is the structured representation of a single row of `devspace status`
+type ComponentStatus struct {
+	Type       string      `json:"type" yaml:"type"`
+	Phase      string      `json:"phase" yaml:"phase"`
+	Pod        string      `json:"pod,omitempty" yaml:"pod,omitempty"`
+	Namespace  string      `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	CreatedAt  string      `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	Message    string      `json:"message,omitempty" yaml:"message,omitempty"`
+	Image      string      `json:"image,omitempty" yaml:"image,omitempty"`
+	Restarts   int32       `json:"restarts,omitempty" yaml:"restarts,omitempty"`
+	Node       string      `json:"node,omitempty" yaml:"node,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+func (status *ComponentStatus) asRow() []string {
+	return []string{
+		status.Type,
+		status.Phase,
+		status.Pod,
+		status.Namespace,
+		status.Message,
+	}
+}
+
+func (status *ComponentStatus) asWideRow() []string {
+	return []string{
+		status.Type,
+		status.Phase,
+		status.Pod,
+		status.Namespace,
+		status.Image,
+		fmt.Sprintf("%d", status.Restarts),
+		status.Node,
+		status.Message,
+	}
+}
+
+// StatusReport is the full, typed result of `devspace status`
+type StatusReport struct {
+	Tiller   ComponentStatus   `json:"tiller" yaml:"tiller"`
+	Registry ComponentStatus   `json:"registry" yaml:"registry"`
+	Devspace ComponentStatus   `json:"devspace" yaml:"devspace"`
+	Extras   []ComponentStatus `json:"extras,omitempty" yaml:"extras,omitempty"`
+}
+
+// Print renders the report using the format requested through `-o/--output` (table by default)
+func (report *StatusReport) Print(output string) {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+
+		if err != nil {
+			log.Fatalf("Unable to marshal status report: %s", err.Error())
+		}
+
+		fmt.Println(string(data))
+	case "yaml":
+		data, err := yaml.Marshal(report)
+
+		if err != nil {
+			log.Fatalf("Unable to marshal status report: %s", err.Error())
+		}
+
+		fmt.Println(string(data))
+	case "wide":
+		headerValues := []string{"TYPE", "STATUS", "POD", "NAMESPACE", "IMAGE", "RESTARTS", "NODE", "INFO"}
+		values := [][]string{}
+
+		for _, status := range report.all() {
+			values = append(values, status.asWideRow())
+		}
+
+		log.PrintTable(headerValues, values)
+	default:
+		headerValues := []string{"TYPE", "STATUS", "POD", "NAMESPACE", "INFO"}
+		values := [][]string{}
+
+		for _, status := range report.all() {
+			values = append(values, status.asRow())
+		}
+
+		log.PrintTable(headerValues, values)
+	}
+}
+
+func (report *StatusReport) all() []ComponentStatus {
+	all := []ComponentStatus{report.Tiller, report.Registry, report.Devspace}
+
+	return append(all, report.Extras...)
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	syncstatus "github.com/covexo/devspace/pkg/devspace/sync/status"
+	"github.com/covexo/devspace/pkg/util/log"
+	"github.com/spf13/cobra"
+)
+
+// RunStatusSync executes the devspace status sync command logic
+func (cmd *StatusCmd) RunStatusSync(cobraCmd *cobra.Command, args []string) {
+	loadConfig(&cmd.workdir, &cmd.privateConfig, &cmd.dsConfig)
+
+	for {
+		if cmd.flags.Watch {
+			// Clear the terminal so each refresh replaces the previous table
+			fmt.Print("\033[H\033[2J")
+		}
+
+		rows, haveTelemetry, err := cmd.getSyncStatusRows()
+
+		if err != nil {
+			log.Fatalf("Unable to determine sync status: %s", err.Error())
+		}
+
+		if !haveTelemetry {
+			log.Warn("No sync telemetry found yet - is `devspace sync` running for this workdir?")
+		}
+
+		if cmd.flags.Output == "json" {
+			data, err := json.MarshalIndent(rows, "", "  ")
+
+			if err != nil {
+				log.Fatalf("Unable to marshal sync status: %s", err.Error())
+			}
+
+			fmt.Println(string(data))
+		} else {
+			headerValues := []string{
+				"LOCAL",
+				"REMOTE",
+				"CONTAINER",
+				"DIRECTION",
+				"STATE",
+				"UPLOAD",
+				"DOWNLOAD",
+				"BYTES",
+				"LAST SYNC",
+				"LAST ERROR",
+			}
+			values := make([][]string, 0, len(rows))
+
+			for _, row := range rows {
+				values = append(values, row.asTableRow())
+			}
+
+			log.PrintTable(headerValues, values)
+		}
+
+		if !cmd.flags.Watch {
+			return
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+}
+
+// syncStatusRow is the flattened, renderable view of a single sync mapping's telemetry
+type syncStatusRow struct {
+	LocalPath     string `json:"localPath"`
+	ContainerPath string `json:"containerPath"`
+	Container     string `json:"container"`
+	Direction     string `json:"direction"`
+	State         string `json:"state"`
+	UploadQueue   int    `json:"uploadQueue"`
+	DownloadQueue int    `json:"downloadQueue"`
+	BytesSynced   int64  `json:"bytesSynced"`
+	LastSync      string `json:"lastSync"`
+	LastError     string `json:"lastError,omitempty"`
+}
+
+func (row *syncStatusRow) asTableRow() []string {
+	lastSync := "-"
+
+	if row.LastSync != "" {
+		lastSync = row.LastSync
+	}
+
+	return []string{
+		row.LocalPath,
+		row.ContainerPath,
+		row.Container,
+		row.Direction,
+		row.State,
+		fmt.Sprintf("%d", row.UploadQueue),
+		fmt.Sprintf("%d", row.DownloadQueue),
+		fmt.Sprintf("%d", row.BytesSynced),
+		lastSync,
+		row.LastError,
+	}
+}
+
+// getSyncStatusRows joins the configured sync mappings with the last telemetry written via
+// pkg/devspace/sync/status, so `status sync` can attach without disturbing the transfer. The second
+// return value reports whether any telemetry was found at all - until a sync engine writes to the
+// status file, every mapping legitimately has none and callers should say so rather than implying a
+// healthy, idle sync
+func (cmd *StatusCmd) getSyncStatusRows() ([]*syncStatusRow, bool, error) {
+	statuses, err := syncstatus.Read(cmd.workdir)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	rows := make([]*syncStatusRow, 0, len(cmd.dsConfig.Sync))
+
+	for _, mapping := range cmd.dsConfig.Sync {
+		key := mapping.LocalSubPath + ":" + mapping.ContainerPath
+		row := &syncStatusRow{
+			LocalPath:     mapping.LocalSubPath,
+			ContainerPath: mapping.ContainerPath,
+			Container:     mapping.ContainerName,
+			Direction:     "<->",
+			State:         string(syncstatus.StateUnknown),
+		}
+
+		if status, ok := statuses[key]; ok {
+			row.Container = status.Container
+			row.Direction = status.Direction
+			row.State = string(status.State)
+			row.UploadQueue = status.UploadQueue
+			row.DownloadQueue = status.DownloadQueue
+			row.BytesSynced = status.BytesSynced
+			row.LastError = status.LastError
+
+			if !status.LastSync.IsZero() {
+				row.LastSync = status.LastSync.Format(time.RFC3339)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, len(statuses) > 0, nil
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/covexo/devspace/pkg/devspace/clients/kubectl"
+	"github.com/covexo/devspace/pkg/devspace/config/v1"
+	"github.com/covexo/devspace/pkg/devspace/sync"
+	"github.com/covexo/devspace/pkg/util/log"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SyncCmd holds the information needed for the sync command
+type SyncCmd struct {
+	kubectl       *kubernetes.Clientset
+	privateConfig *v1.PrivateConfig
+	dsConfig      *v1.DevSpaceConfig
+	workdir       string
+}
+
+func init() {
+	cmd := &SyncCmd{}
+
+	syncCmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Starts the devspace file sync",
+		Long: `
+	#######################################################
+	#################### devspace sync #####################
+	#######################################################
+	Syncs the configured local paths into the running
+	devspace pod until interrupted. Progress can be watched
+	from another terminal with "devspace status sync"
+	#######################################################
+	`,
+		Run: cmd.RunSync,
+	}
+
+	rootCmd.AddCommand(syncCmd)
+}
+
+// RunSync executes the devspace sync command logic
+func (cmd *SyncCmd) RunSync(cobraCmd *cobra.Command, args []string) {
+	loadConfig(&cmd.workdir, &cmd.privateConfig, &cmd.dsConfig)
+
+	var err error
+	cmd.kubectl, err = kubectl.NewClient()
+
+	if err != nil {
+		log.Fatalf("Unable to create new kubectl client: %s", err.Error())
+	}
+
+	if len(cmd.dsConfig.Sync) == 0 {
+		log.Fatal("No sync paths configured")
+	}
+
+	pod, err := kubectl.ResolveDevspacePod(cmd.kubectl, cmd.privateConfig)
+
+	if err != nil {
+		log.Fatalf("Unable to find running devspace pod: %s", err.Error())
+	}
+
+	engine := sync.NewEngine(cmd.workdir, cmd.kubectl, pod, cmd.dsConfig.Sync)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		<-signals
+		log.Info("Stopping devspace sync")
+		cancel()
+	}()
+
+	log.Infof("Starting sync into pod %s", pod.GetName())
+
+	engine.Start(ctx)
+}
@@ -0,0 +1,149 @@
+package kubectl
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+// RelatedObject identifies a single Kubernetes object that an event can be involved with
+type RelatedObject struct {
+	Kind string
+	Name string
+}
+
+// ResolveReleaseObjects returns the pods belonging to releaseName together with the objects that own
+// them (ReplicaSets, Deployments) and the PersistentVolumeClaims they mount. It is the set of objects
+// whose events are relevant when something about the release looks wrong
+func ResolveReleaseObjects(kubectlClient *kubernetes.Clientset, namespace, releaseName string) ([]RelatedObject, error) {
+	pods, err := kubectlClient.Core().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: "release=" + releaseName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[RelatedObject]bool{}
+	related := []RelatedObject{}
+
+	add := func(obj RelatedObject) {
+		if !seen[obj] {
+			seen[obj] = true
+			related = append(related, obj)
+		}
+	}
+
+	for _, pod := range pods.Items {
+		add(RelatedObject{Kind: "Pod", Name: pod.GetName()})
+
+		for _, owner := range pod.GetOwnerReferences() {
+			add(RelatedObject{Kind: owner.Kind, Name: owner.Name})
+
+			if owner.Kind == "ReplicaSet" {
+				if replicaSet, err := kubectlClient.AppsV1().ReplicaSets(namespace).Get(owner.Name, metav1.GetOptions{}); err == nil {
+					for _, rsOwner := range replicaSet.GetOwnerReferences() {
+						add(RelatedObject{Kind: rsOwner.Kind, Name: rsOwner.Name})
+					}
+				}
+			}
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				add(RelatedObject{Kind: "PersistentVolumeClaim", Name: volume.PersistentVolumeClaim.ClaimName})
+			}
+		}
+	}
+
+	return related, nil
+}
+
+// relevant reports whether an event's InvolvedObject is in the related object set
+func relevant(event *corev1.Event, related []RelatedObject) bool {
+	for _, obj := range related {
+		if event.InvolvedObject.Kind == obj.Kind && event.InvolvedObject.Name == obj.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetReleaseEvents lists the events for a release namespace, filtered to the pods of releaseName and
+// the objects that own or are referenced by them. since and eventType are optional filters; a zero
+// since includes all events and an empty eventType includes every type. The returned resourceVersion
+// is the List call's, so a subsequent WatchReleaseEvents can start exactly where this snapshot ended
+// instead of replaying it
+func GetReleaseEvents(kubectlClient *kubernetes.Clientset, namespace, releaseName string, since time.Duration, eventType string) ([]corev1.Event, string, error) {
+	related, err := ResolveReleaseObjects(kubectlClient, namespace, releaseName)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	events, err := kubectlClient.Core().Events(namespace).List(metav1.ListOptions{})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	cutoff := time.Time{}
+
+	if since > 0 {
+		cutoff = time.Now().Add(-since)
+	}
+
+	filtered := make([]corev1.Event, 0, len(events.Items))
+
+	for _, event := range events.Items {
+		if !relevant(&event, related) {
+			continue
+		}
+
+		if eventType != "" && event.Type != eventType {
+			continue
+		}
+
+		if !cutoff.IsZero() && event.LastTimestamp.Time.Before(cutoff) {
+			continue
+		}
+
+		filtered = append(filtered, event)
+	}
+
+	return filtered, events.GetResourceVersion(), nil
+}
+
+// WatchReleaseEvents opens a watch on every event of namespace and returns it together with the
+// objects related to releaseName. sinceResourceVersion should be the resourceVersion returned by a
+// prior GetReleaseEvents call so the watch only emits events after that snapshot instead of replaying
+// the whole current list as a burst of ADDED events. The watch itself is not filtered: callers apply
+// IsRelated (the relevance rule of GetReleaseEvents) and any eventType filter themselves when reading
+// from the channel, since the watch API has no server-side InvolvedObject filter across multiple kinds
+func WatchReleaseEvents(kubectlClient *kubernetes.Clientset, namespace, releaseName, sinceResourceVersion string) (watch.Interface, []RelatedObject, error) {
+	related, err := ResolveReleaseObjects(kubectlClient, namespace, releaseName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	watcher, err := kubectlClient.Core().Events(namespace).Watch(metav1.ListOptions{
+		ResourceVersion: sinceResourceVersion,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return watcher, related, nil
+}
+
+// IsRelated reports whether event belongs to one of the related objects, exported so callers of
+// WatchReleaseEvents can apply the same filter used by GetReleaseEvents
+func IsRelated(event *corev1.Event, related []RelatedObject) bool {
+	return relevant(event, related)
+}
@@ -0,0 +1,111 @@
+package kubectl
+
+import (
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/kubectl/pkg/util/term"
+)
+
+// NewConfig loads the same kubeconfig used by NewClient, for callers that need the raw rest.Config
+// instead of (or in addition to) a Clientset, e.g. to open a SPDY exec/attach stream
+func NewConfig() (*rest.Config, error) {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{},
+	).ClientConfig()
+}
+
+// Exec runs command inside container of pod, streaming stdin/stdout/stderr over a SPDY connection,
+// the same way `kubectl exec` does. It is shared by `devspace exec` and, in non-interactive form,
+// by anything else that needs to run a one-off command in the devspace pod
+func Exec(kubectlClient *kubernetes.Clientset, pod *corev1.Pod, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	return stream(kubectlClient, pod, container, command, stdin, stdout, stderr, "exec")
+}
+
+// Attach connects to the already running main process of container in pod, the same way
+// `kubectl attach` does
+func Attach(kubectlClient *kubernetes.Clientset, pod *corev1.Pod, container string, stdin io.Reader, stdout, stderr io.Writer) error {
+	return stream(kubectlClient, pod, container, nil, stdin, stdout, stderr, "attach")
+}
+
+func stream(kubectlClient *kubernetes.Clientset, pod *corev1.Pod, container string, command []string, stdin io.Reader, stdout, stderr io.Writer, subresource string) error {
+	config, err := NewConfig()
+
+	if err != nil {
+		return err
+	}
+
+	tty := term.TTY{
+		In:  stdin,
+		Out: stdout,
+		Raw: true,
+	}
+
+	// A server-side TTY multiplexes stderr into stdout, so it can only be requested when we are
+	// actually attached to an interactive terminal - otherwise stderr must stay a separate stream,
+	// same as kubectl's own exec/attach commands
+	useTTY := tty.IsTerminalIn()
+
+	if !useTTY {
+		tty.Raw = false
+	}
+
+	request := kubectlClient.Core().RESTClient().Post().
+		Resource("pods").
+		Name(pod.GetName()).
+		Namespace(pod.GetNamespace()).
+		SubResource(subresource)
+
+	if subresource == "exec" {
+		request.VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    !useTTY && stderr != nil,
+			TTY:       useTTY,
+		}, scheme.ParameterCodec)
+	} else {
+		request.VersionedParams(&corev1.PodAttachOptions{
+			Container: container,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    !useTTY && stderr != nil,
+			TTY:       useTTY,
+		}, scheme.ParameterCodec)
+	}
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", request.URL())
+
+	if err != nil {
+		return err
+	}
+
+	streamErr := stderr
+
+	if useTTY {
+		streamErr = nil
+	}
+
+	return tty.Safe(func() error {
+		var sizeQueue remotecommand.TerminalSizeQueue
+
+		if useTTY {
+			sizeQueue = tty.MonitorSize(tty.GetSize())
+		}
+
+		return executor.Stream(remotecommand.StreamOptions{
+			Stdin:             stdin,
+			Stdout:            stdout,
+			Stderr:            streamErr,
+			Tty:               useTTY,
+			TerminalSizeQueue: sizeQueue,
+		})
+	})
+}
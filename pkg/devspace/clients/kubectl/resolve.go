@@ -0,0 +1,40 @@
+package kubectl
+
+import (
+	"errors"
+
+	"github.com/covexo/devspace/pkg/devspace/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ListDevspacePods lists every pod belonging to the devspace release, running or not
+func ListDevspacePods(kubectlClient *kubernetes.Clientset, privateConfig *v1.PrivateConfig) (*corev1.PodList, error) {
+	return kubectlClient.Core().Pods(privateConfig.Release.Namespace).List(metav1.ListOptions{
+		LabelSelector: "release=" + privateConfig.Release.Name,
+	})
+}
+
+// ResolveDevspacePod finds the running devspace pod for the current release, the same way
+// `devspace status` does. It is the shared entry point for any command that needs to act on the
+// running devspace pod, e.g. `devspace exec` and `devspace attach`
+func ResolveDevspacePod(kubectlClient *kubernetes.Clientset, privateConfig *v1.PrivateConfig) (*corev1.Pod, error) {
+	pods, err := ListDevspacePods(kubectlClient, privateConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, errors.New("No devspace pod found")
+	}
+
+	for _, pod := range pods.Items {
+		if GetPodStatus(&pod) == "Running" {
+			return &pod, nil
+		}
+	}
+
+	return nil, errors.New("No running devspace pod found")
+}
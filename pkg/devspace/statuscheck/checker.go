@@ -0,0 +1,199 @@
+package statuscheck
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/covexo/devspace/pkg/util/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// Resource describes a single manifest resource together with its readiness verdict
+type Resource struct {
+	Kind      string
+	Name      string
+	Namespace string
+	Ready     bool
+	Message   string
+}
+
+// Checker evaluates the readiness of the Kubernetes resources rendered by a helm release. A helm
+// manifest only tells us which resources exist, never their live Status - that has to be fetched
+// from the API server with kubectl, which is why Ready always re-Gets the object before judging it.
+// kubectl is typed as the kubernetes.Interface so tests can pass a fake clientset.
+type Checker struct {
+	kubectl   kubernetes.Interface
+	namespace string
+}
+
+// NewChecker creates a new Checker that uses kubectl to fetch the live state of a resource before
+// evaluating it. namespace is used as a fallback for manifest objects that omit their namespace
+func NewChecker(kubectl kubernetes.Interface, namespace string) *Checker {
+	return &Checker{
+		kubectl:   kubectl,
+		namespace: namespace,
+	}
+}
+
+// Ready fetches the live version of the object identified by kind/namespace/name from the API server
+// and evaluates its readiness. The decoded manifest object is only used to identify what to fetch -
+// its Status is never populated by helm and must not be trusted.
+func (c *Checker) Ready(ctx context.Context, kind, namespace, name string) (bool, string, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	switch kind {
+	case "Deployment":
+		obj, err := c.kubectl.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.deploymentReady(ctx, obj)
+	case "StatefulSet":
+		obj, err := c.kubectl.AppsV1().StatefulSets(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.statefulSetReady(ctx, obj)
+	case "DaemonSet":
+		obj, err := c.kubectl.AppsV1().DaemonSets(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.daemonSetReady(ctx, obj)
+	case "ReplicaSet":
+		obj, err := c.kubectl.AppsV1().ReplicaSets(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.replicaSetReady(ctx, obj)
+	case "Pod":
+		obj, err := c.kubectl.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.podReady(ctx, obj)
+	case "PersistentVolumeClaim":
+		obj, err := c.kubectl.CoreV1().PersistentVolumeClaims(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.pvcReady(ctx, obj)
+	case "Service":
+		obj, err := c.kubectl.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.serviceReady(ctx, obj)
+	case "Job":
+		obj, err := c.kubectl.BatchV1().Jobs(namespace).Get(name, metav1.GetOptions{})
+
+		if err != nil {
+			return false, "", err
+		}
+
+		return c.jobReady(ctx, obj)
+	default:
+		return true, "Not checked", nil
+	}
+}
+
+// CheckManifest splits a rendered helm manifest into its individual documents and decodes each one
+// far enough to know its kind/namespace/name, then calls Ready to fetch the live object and judge it.
+// Unknown or undecodable documents are skipped, the latter with a warning since a decode failure
+// usually means the manifest itself is malformed.
+func (c *Checker) CheckManifest(ctx context.Context, manifest string) ([]Resource, error) {
+	decoder := scheme.Codecs.UniversalDeserializer()
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(bytes.NewBufferString(manifest)))
+	resources := []Resource{}
+
+	for {
+		doc, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return resources, err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				// Resource kind the client-go scheme does not know, e.g. a custom resource - skip silently
+				continue
+			}
+
+			log.Warnf("Skipping undecodable manifest entry: %s", err.Error())
+			continue
+		}
+
+		accessor, err := meta.Accessor(obj)
+
+		if err != nil {
+			continue
+		}
+
+		// The decoded object's own TypeMeta is not reliably populated by UniversalDeserializer, so the
+		// kind must come from the GVK the decoder itself resolved, not obj.GetObjectKind()
+		kind := gvk.Kind
+		ready, message, err := c.Ready(ctx, kind, accessor.GetNamespace(), accessor.GetName())
+
+		if err != nil {
+			message = err.Error()
+		}
+
+		resources = append(resources, Resource{
+			Kind:      kind,
+			Name:      accessor.GetName(),
+			Namespace: accessor.GetNamespace(),
+			Ready:     ready,
+			Message:   message,
+		})
+	}
+
+	return resources, nil
+}
+
+// AllReady returns true if every resource in the slice reports ready
+func AllReady(resources []Resource) bool {
+	for _, resource := range resources {
+		if !resource.Ready {
+			return false
+		}
+	}
+
+	return true
+}
+
+func notReady(format string, args ...interface{}) (bool, string, error) {
+	return false, fmt.Sprintf(format, args...), nil
+}
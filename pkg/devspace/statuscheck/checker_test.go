@@ -0,0 +1,62 @@
+package statuscheck
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const deploymentManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+  namespace: default
+spec:
+  replicas: 1
+`
+
+// TestCheckManifestDispatchesDecodedKind guards against using obj.GetObjectKind() instead of the GVK
+// returned by Decode - the former is not reliably populated and silently sends every resource down
+// the "Not checked" default branch, disabling every per-kind readiness check this package exists for
+func TestCheckManifestDispatchesDecodedKind(t *testing.T) {
+	replicas := int32(1)
+	client := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-app",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			AvailableReplicas:  1,
+		},
+	})
+
+	checker := NewChecker(client, "default")
+	resources, err := checker.CheckManifest(context.Background(), deploymentManifest)
+
+	if err != nil {
+		t.Fatalf("CheckManifest returned an error: %s", err)
+	}
+
+	if len(resources) != 1 {
+		t.Fatalf("expected 1 resource, got %d", len(resources))
+	}
+
+	if resources[0].Kind != "Deployment" {
+		t.Fatalf("expected kind %q, got %q", "Deployment", resources[0].Kind)
+	}
+
+	if !resources[0].Ready {
+		t.Fatalf("expected deployment to be ready, got message %q", resources[0].Message)
+	}
+}
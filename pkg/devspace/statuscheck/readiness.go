@@ -0,0 +1,137 @@
+package statuscheck
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var podBackoffReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"CreateContainerConfigError": true,
+}
+
+func (c *Checker) deploymentReady(ctx context.Context, deployment *appsv1.Deployment) (bool, string, error) {
+	status := deployment.Status
+	spec := deployment.Spec
+
+	if status.ObservedGeneration < deployment.GetGeneration() {
+		return notReady("Waiting for deployment spec update to be observed")
+	}
+
+	if spec.Replicas != nil && status.UpdatedReplicas < *spec.Replicas {
+		return notReady("%d out of %d new replicas have been updated", status.UpdatedReplicas, *spec.Replicas)
+	}
+
+	if spec.Replicas != nil && status.Replicas > status.UpdatedReplicas {
+		return notReady("%d old replicas are pending termination", status.Replicas-status.UpdatedReplicas)
+	}
+
+	if spec.Replicas != nil && status.AvailableReplicas < *spec.Replicas {
+		return notReady("%d of %d updated replicas are available", status.AvailableReplicas, *spec.Replicas)
+	}
+
+	return true, "Running", nil
+}
+
+func (c *Checker) statefulSetReady(ctx context.Context, statefulSet *appsv1.StatefulSet) (bool, string, error) {
+	status := statefulSet.Status
+	spec := statefulSet.Spec
+
+	if status.ObservedGeneration < statefulSet.GetGeneration() {
+		return notReady("Waiting for statefulset spec update to be observed")
+	}
+
+	if spec.Replicas != nil && status.ReadyReplicas < *spec.Replicas {
+		return notReady("%d of %d replicas are ready", status.ReadyReplicas, *spec.Replicas)
+	}
+
+	if spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType && status.UpdateRevision != status.CurrentRevision {
+		return notReady("Waiting for statefulset rolling update to complete")
+	}
+
+	return true, "Running", nil
+}
+
+func (c *Checker) daemonSetReady(ctx context.Context, daemonSet *appsv1.DaemonSet) (bool, string, error) {
+	status := daemonSet.Status
+
+	if status.NumberReady < status.DesiredNumberScheduled {
+		return notReady("%d of %d daemon pods are ready", status.NumberReady, status.DesiredNumberScheduled)
+	}
+
+	if status.UpdatedNumberScheduled < status.DesiredNumberScheduled {
+		return notReady("%d of %d daemon pods are updated", status.UpdatedNumberScheduled, status.DesiredNumberScheduled)
+	}
+
+	return true, "Running", nil
+}
+
+func (c *Checker) replicaSetReady(ctx context.Context, replicaSet *appsv1.ReplicaSet) (bool, string, error) {
+	spec := replicaSet.Spec
+	status := replicaSet.Status
+
+	if spec.Replicas != nil && status.ReadyReplicas < *spec.Replicas {
+		return notReady("%d of %d replicas are ready", status.ReadyReplicas, *spec.Replicas)
+	}
+
+	return true, "Running", nil
+}
+
+func (c *Checker) podReady(ctx context.Context, pod *corev1.Pod) (bool, string, error) {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if waiting := containerStatus.State.Waiting; waiting != nil && podBackoffReasons[waiting.Reason] {
+			return notReady("Container %s is %s: %s", containerStatus.Name, waiting.Reason, waiting.Message)
+		}
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status != corev1.ConditionTrue {
+			return notReady("Pod is not ready: %s", condition.Message)
+		}
+	}
+
+	return true, "Running", nil
+}
+
+func (c *Checker) pvcReady(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, string, error) {
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return notReady("PersistentVolumeClaim is %s", pvc.Status.Phase)
+	}
+
+	return true, "Bound", nil
+}
+
+func (c *Checker) serviceReady(ctx context.Context, service *corev1.Service) (bool, string, error) {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return true, "Running", nil
+	}
+
+	if len(service.Status.LoadBalancer.Ingress) == 0 {
+		return notReady("Waiting for load balancer to be assigned")
+	}
+
+	return true, "Running", nil
+}
+
+func (c *Checker) jobReady(ctx context.Context, job *batchv1.Job) (bool, string, error) {
+	if job.Status.Failed > 0 {
+		return notReady("Job has %d failed pod(s)", job.Status.Failed)
+	}
+
+	completions := int32(1)
+
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+
+	if job.Status.Succeeded < completions {
+		return notReady("%d of %d completions succeeded", job.Status.Succeeded, completions)
+	}
+
+	return true, "Complete", nil
+}
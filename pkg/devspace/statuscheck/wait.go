@@ -0,0 +1,28 @@
+package statuscheck
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Wait polls the manifest every interval until every resource is ready or timeout elapses. It returns
+// the last seen set of resources, so callers can still report what was blocking readiness on timeout.
+func (c *Checker) Wait(ctx context.Context, manifest string, timeout, interval time.Duration) ([]Resource, error) {
+	var resources []Resource
+
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(pollCtx context.Context) (bool, error) {
+		var pollErr error
+
+		resources, pollErr = c.CheckManifest(pollCtx, manifest)
+
+		if pollErr != nil {
+			return false, pollErr
+		}
+
+		return AllReady(resources), nil
+	})
+
+	return resources, err
+}
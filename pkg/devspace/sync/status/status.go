@@ -0,0 +1,92 @@
+package status
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// State describes the current activity of a single sync mapping
+type State string
+
+const (
+	// StateIdle means the mapping is up to date and waiting for changes
+	StateIdle State = "Idle"
+	// StateSyncing means files are currently being transferred
+	StateSyncing State = "Syncing"
+	// StateError means the last sync attempt failed
+	StateError State = "Error"
+	// StatePaused means the mapping was paused by the user
+	StatePaused State = "Paused"
+	// StateUnknown means no telemetry has been written for this mapping yet, e.g. because no sync
+	// process has run for it
+	StateUnknown State = "Unknown"
+)
+
+// MappingStatus is the point-in-time telemetry for a single configured sync mapping
+type MappingStatus struct {
+	LocalPath     string    `json:"localPath"`
+	ContainerPath string    `json:"containerPath"`
+	Pod           string    `json:"pod"`
+	Container     string    `json:"container"`
+	Direction     string    `json:"direction"`
+	State         State     `json:"state"`
+	UploadQueue   int       `json:"uploadQueue"`
+	DownloadQueue int       `json:"downloadQueue"`
+	BytesSynced   int64     `json:"bytesSynced"`
+	LastError     string    `json:"lastError,omitempty"`
+	LastSync      time.Time `json:"lastSync,omitempty"`
+}
+
+const fileName = "sync.status.json"
+
+// FilePath returns the path of the status file that a running sync process is expected to write to
+// and that `devspace status sync` reads from
+func FilePath(workdir string) string {
+	return filepath.Join(workdir, ".devspace", fileName)
+}
+
+// Write persists the current status of all mappings so it can be read by another process without
+// disturbing the transfer. It is called by pkg/devspace/sync.Engine's goroutines after every sync
+// pass; if no `devspace sync` process has ever written for this workdir, Read returns an empty map
+// and `status sync` reports every mapping as StateUnknown. The file is not removed when the sync
+// process exits, so Read then returns the last status it wrote
+func Write(workdir string, statuses map[string]*MappingStatus) error {
+	path := FilePath(workdir)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(statuses)
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Read loads the last status written via Write. It returns an empty map without error if no sync
+// process has ever written telemetry for this workdir
+func Read(workdir string) (map[string]*MappingStatus, error) {
+	data, err := ioutil.ReadFile(FilePath(workdir))
+
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]*MappingStatus{}, nil
+		}
+
+		return nil, err
+	}
+
+	statuses := map[string]*MappingStatus{}
+
+	if err := json.Unmarshal(data, &statuses); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}